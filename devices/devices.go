@@ -128,6 +128,21 @@ func (r RelativeHumidity) String() string {
 	return fmt.Sprintf("%d.%02d%%rH", r/100, r%100)
 }
 
+// Illuminance is luminous flux per unit area at a precision of 0.001lx.
+//
+// Expected range is [0, >150000000].
+type Illuminance Milli
+
+// Float64 returns the value as float64 with 0.001 precision.
+func (i Illuminance) Float64() float64 {
+	return Milli(i).Float64()
+}
+
+// String returns the illuminance formatted as a string.
+func (i Illuminance) String() string {
+	return Milli(i).String() + "lx"
+}
+
 // Environment represents measurements from an environmental sensor.
 type Environment struct {
 	Temperature Celsius
@@ -68,3 +68,13 @@ func TestRelativeHumidity(t *testing.T) {
 		t.Fatalf("%f", f)
 	}
 }
+
+func TestIlluminance(t *testing.T) {
+	o := Illuminance(10010)
+	if s := o.String(); s != "10.010lx" {
+		t.Fatalf("%#v", s)
+	}
+	if f := o.Float64(); f > 10.011 || f < 10.009 {
+		t.Fatalf("%f", f)
+	}
+}